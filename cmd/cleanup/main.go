@@ -0,0 +1,97 @@
+// Command cleanup remove os hosts de teste de carga (e o grupo que os
+// contém) criados por cmd/setup, para que execuções repetidas não acumulem
+// hosts obsoletos no banco de dados do Zabbix.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"zabbix_loadtest/zabbixapi"
+)
+
+const (
+	groupName      = "PerformanceTestGroup"
+	hostNamePrefix = "PerfTestHost"
+)
+
+func main() {
+	var (
+		apiURL string
+		token  string
+	)
+
+	fmt.Print("URL do Zabbix (ex.: https://127.0.0.1/zabbix/api_jsonrpc.php): ")
+	fmt.Scanln(&apiURL)
+	fmt.Print("Token da API: ")
+	fmt.Scanln(&token)
+
+	client := zabbixapi.New(apiURL, token)
+
+	groupID, found, err := findGroup(client)
+	if err != nil {
+		log.Fatalf("Erro ao buscar grupo: %v", err)
+	}
+	if !found {
+		fmt.Println("Grupo de teste não encontrado, nada para limpar.")
+		return
+	}
+
+	hostIDs, err := listTestHostIDs(client, groupID)
+	if err != nil {
+		log.Fatalf("Erro ao listar hosts: %v", err)
+	}
+
+	if len(hostIDs) > 0 {
+		if _, err := client.Call("host.delete", hostIDs); err != nil {
+			log.Fatalf("Erro ao remover hosts: %v", err)
+		}
+		fmt.Printf("%d host(s) removido(s).\n", len(hostIDs))
+	}
+
+	if _, err := client.Call("hostgroup.delete", []string{groupID}); err != nil {
+		log.Fatalf("Erro ao remover grupo: %v", err)
+	}
+	fmt.Println("Grupo de teste removido.")
+}
+
+// findGroup localiza o grupo de testes, retornando found=false se ele não existir.
+func findGroup(client *zabbixapi.Client) (id string, found bool, err error) {
+	res, err := client.Call("hostgroup.get", map[string]interface{}{
+		"output": "extend",
+		"filter": map[string]string{"name": groupName},
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	var groups []map[string]interface{}
+	json.Unmarshal(res, &groups)
+	if len(groups) == 0 {
+		return "", false, nil
+	}
+	return groups[0]["groupid"].(string), true, nil
+}
+
+// listTestHostIDs lista os IDs de todos os hosts "PerfTestHost-*" do grupo groupID.
+func listTestHostIDs(client *zabbixapi.Client, groupID string) ([]string, error) {
+	res, err := client.Call("host.get", map[string]interface{}{
+		"output":      "extend",
+		"groupids":    groupID,
+		"search":      map[string]string{"host": hostNamePrefix + "-"},
+		"startSearch": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []map[string]interface{}
+	json.Unmarshal(res, &hosts)
+
+	hostIDs := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		hostIDs = append(hostIDs, host["hostid"].(string))
+	}
+	return hostIDs, nil
+}
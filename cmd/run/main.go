@@ -0,0 +1,90 @@
+// Command run executa um cenário de carga contra os hosts já provisionados
+// por cmd/setup, enviando valores via protocolo trapper e publicando
+// métricas Prometheus durante a execução.
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"zabbix_loadtest/metrics"
+	"zabbix_loadtest/sender"
+	"zabbix_loadtest/workload"
+)
+
+func main() {
+	var (
+		serverDNS       string
+		numHosts        int
+		testDurationSec int
+		scenarioPath    string
+		targetNVPS      float64
+		metricsPort     int
+	)
+
+	fmt.Print("Zabbix Server (ex.: 127.0.0.1): ")
+	fmt.Scanln(&serverDNS)
+	fmt.Print("Número de hosts de teste: ")
+	fmt.Scanln(&numHosts)
+	fmt.Print("Duração do teste em segundos: ")
+	fmt.Scanln(&testDurationSec)
+	fmt.Print("Arquivo de cenário YAML/JSON (opcional, Enter para usar os parâmetros acima): ")
+	fmt.Scanln(&scenarioPath)
+	if scenarioPath == "" {
+		fmt.Print("Taxa alvo em NVPS por host (0 para o ritmo padrão de ~100/s): ")
+		fmt.Scanln(&targetNVPS)
+	}
+	fmt.Print("Porta do endpoint /metrics (0 para desabilitar): ")
+	fmt.Scanln(&metricsPort)
+
+	if metricsPort > 0 {
+		metricsServer := metrics.StartServer(metricsPort)
+		defer metrics.Shutdown(metricsServer)
+		fmt.Printf("Métricas expostas em http://localhost:%d/metrics\n", metricsPort)
+	}
+
+	trapperSender := sender.New(sender.Config{Address: fmt.Sprintf("%s:10051", serverDNS)})
+	defer trapperSender.Close()
+
+	scenario := buildDefaultScenario(numHosts, testDurationSec, targetNVPS)
+	if scenarioPath != "" {
+		loaded, err := workload.LoadScenario(scenarioPath)
+		if err != nil {
+			log.Fatalf("Erro ao carregar cenário: %v", err)
+		}
+		scenario = loaded
+	}
+
+	fmt.Println("\nIniciando envio de dados via protocolo trapper...")
+	engine := workload.NewEngine(trapperSender, scenario)
+	summary, err := engine.Run()
+	if err != nil {
+		log.Fatalf("Erro ao executar cenário de carga: %v", err)
+	}
+
+	fmt.Println("\nTeste de performance concluído.")
+	fmt.Print(summary.String())
+	if err := summary.WriteJSON("report.json"); err != nil {
+		log.Printf("[ERRO] Falha ao gravar report.json: %v", err)
+	}
+	if err := summary.WriteText("report.txt"); err != nil {
+		log.Printf("[ERRO] Falha ao gravar report.txt: %v", err)
+	}
+}
+
+// buildDefaultScenario monta um Scenario equivalente aos parâmetros
+// informados interativamente, usado quando nenhum arquivo de cenário é
+// fornecido.
+func buildDefaultScenario(numHosts, testDurationSec int, targetNVPS float64) *workload.Scenario {
+	if targetNVPS <= 0 {
+		targetNVPS = 100
+	}
+	return &workload.Scenario{
+		Hosts: workload.HostsConfig{Prefix: "PerfTestHost", Count: numHosts},
+		Items: []workload.ItemConfig{
+			{Key: "perf.test[1]", Generator: "counter"},
+		},
+		Rate:        workload.RateConfig{NVPSPerHost: targetNVPS},
+		DurationSec: testDurationSec,
+	}
+}
@@ -0,0 +1,316 @@
+// Command setup provisiona o grupo, os hosts e os itens trapper usados pelo
+// load test, opcionalmente vinculando templates existentes aos hosts criados.
+//
+// Hosts e itens são provisionados em lote: um único host.get localiza os
+// hosts já existentes, um único host.create cria os que faltam, e os itens
+// de cada host são resolvidos e criados em uma única chamada item.create por
+// host, distribuída por um pool de workers concorrente.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"zabbix_loadtest/zabbixapi"
+)
+
+const (
+	groupName         = "PerformanceTestGroup"
+	hostNamePrefix    = "PerfTestHost"
+	defaultNumWorkers = 32
+)
+
+func main() {
+	var (
+		apiURL        string
+		serverDNS     string
+		token         string
+		numHosts      int
+		numItems      int
+		templateNames string
+		numWorkers    int
+	)
+
+	fmt.Print("URL do Zabbix (ex.: https://127.0.0.1/zabbix/api_jsonrpc.php): ")
+	fmt.Scanln(&apiURL)
+	fmt.Print("Zabbix Server (ex.: 127.0.0.1): ")
+	fmt.Scanln(&serverDNS)
+	fmt.Print("Token da API: ")
+	fmt.Scanln(&token)
+	fmt.Print("Número de hosts de teste: ")
+	fmt.Scanln(&numHosts)
+	fmt.Print("Número de itens por host: ")
+	fmt.Scanln(&numItems)
+	fmt.Print("Templates a vincular aos hosts (nomes separados por vírgula, opcional): ")
+	templateNames = readLine()
+	fmt.Print("Número de workers concorrentes (Enter para 32): ")
+	fmt.Scanln(&numWorkers)
+	if numWorkers <= 0 {
+		numWorkers = defaultNumWorkers
+	}
+
+	client := zabbixapi.New(apiURL, token)
+
+	templateIDs, err := resolveTemplateIDs(client, templateNames)
+	if err != nil {
+		log.Fatalf("Erro ao resolver templates: %v", err)
+	}
+
+	groupID, err := ensureGroup(client)
+	if err != nil {
+		log.Fatalf("Erro ao preparar grupo: %v", err)
+	}
+
+	hostNames := make([]string, numHosts)
+	for i := range hostNames {
+		hostNames[i] = fmt.Sprintf("%s-%d", hostNamePrefix, i+1)
+	}
+
+	hostIDs, err := ensureHosts(client, hostNames, groupID, serverDNS, templateIDs)
+	if err != nil {
+		log.Fatalf("Erro ao preparar hosts: %v", err)
+	}
+
+	if err := ensureItemsConcurrently(client, hostNames, hostIDs, numItems, numWorkers); err != nil {
+		log.Fatalf("Erro ao preparar itens: %v", err)
+	}
+
+	fmt.Println("\nProvisionamento concluído.")
+}
+
+// readLine lê uma linha inteira da entrada padrão. Diferente de fmt.Scanln,
+// não trunca no primeiro espaço em branco, o que é necessário para aceitar
+// nomes de template com espaço (ex.: "Linux by Zabbix agent").
+func readLine() string {
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.TrimRight(line, "\r\n")
+}
+
+// resolveTemplateIDs busca o templateid de cada nome em templateNames (lista
+// separada por vírgulas), retornando os parâmetros prontos para o array
+// "templates" de host.create.
+func resolveTemplateIDs(client *zabbixapi.Client, templateNames string) ([]map[string]string, error) {
+	templateNames = strings.TrimSpace(templateNames)
+	if templateNames == "" {
+		return nil, nil
+	}
+
+	var templates []map[string]string
+	for _, name := range strings.Split(templateNames, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		res, err := client.Call("template.get", map[string]interface{}{
+			"output": "extend",
+			"filter": map[string]string{"host": name},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar template %s: %v", name, err)
+		}
+
+		var found []map[string]interface{}
+		json.Unmarshal(res, &found)
+		if len(found) == 0 {
+			return nil, fmt.Errorf("template %q não encontrado", name)
+		}
+
+		templates = append(templates, map[string]string{"templateid": found[0]["templateid"].(string)})
+	}
+	return templates, nil
+}
+
+// ensureGroup busca o grupo de testes, criando-o caso ainda não exista.
+func ensureGroup(client *zabbixapi.Client) (string, error) {
+	res, err := client.Call("hostgroup.get", map[string]interface{}{
+		"output": "extend",
+		"filter": map[string]string{"name": groupName},
+	})
+	if err != nil {
+		return "", fmt.Errorf("erro ao buscar grupo: %v", err)
+	}
+
+	var existingGroups []map[string]interface{}
+	json.Unmarshal(res, &existingGroups)
+	if len(existingGroups) > 0 {
+		groupID := existingGroups[0]["groupid"].(string)
+		log.Printf("[INFO] Grupo de teste já existe, ID: %s", groupID)
+		return groupID, nil
+	}
+
+	res, err = client.Call("hostgroup.create", map[string]interface{}{"name": groupName})
+	if err != nil {
+		return "", fmt.Errorf("erro ao criar grupo: %v", err)
+	}
+	var groupCreateResp map[string][]string
+	json.Unmarshal(res, &groupCreateResp)
+	groupID := groupCreateResp["groupids"][0]
+	log.Printf("[INFO] Grupo de teste criado, ID: %s", groupID)
+	return groupID, nil
+}
+
+// ensureHosts resolve o hostid de cada host em hostNames com uma única
+// chamada host.get, cria em uma única chamada host.create os que ainda não
+// existem (já vinculados a templateIDs, se houver), e retorna o hostid de
+// cada host, na mesma ordem de hostNames.
+func ensureHosts(client *zabbixapi.Client, hostNames []string, groupID, serverDNS string, templateIDs []map[string]string) ([]string, error) {
+	res, err := client.Call("host.get", map[string]interface{}{
+		"output": "extend",
+		"filter": map[string]interface{}{"host": hostNames},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar hosts: %v", err)
+	}
+
+	var existingHosts []map[string]interface{}
+	json.Unmarshal(res, &existingHosts)
+
+	hostIDByName := make(map[string]string, len(existingHosts))
+	for _, host := range existingHosts {
+		hostIDByName[host["host"].(string)] = host["hostid"].(string)
+	}
+	log.Printf("[INFO] %d/%d hosts já existem", len(hostIDByName), len(hostNames))
+
+	var toCreate []map[string]interface{}
+	for _, hostName := range hostNames {
+		if _, ok := hostIDByName[hostName]; ok {
+			continue
+		}
+		params := map[string]interface{}{
+			"host": hostName,
+			"interfaces": []map[string]interface{}{
+				{
+					"type":  1, // Agent
+					"main":  1,
+					"useip": 1,
+					"ip":    serverDNS,
+					"dns":   "",
+					"port":  "10050",
+				},
+			},
+			"groups": []map[string]string{{"groupid": groupID}},
+		}
+		if len(templateIDs) > 0 {
+			params["templates"] = templateIDs
+		}
+		toCreate = append(toCreate, params)
+	}
+
+	if len(toCreate) > 0 {
+		res, err := client.Call("host.create", toCreate)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao criar %d hosts: %v", len(toCreate), err)
+		}
+		var hCreateResp map[string][]string
+		json.Unmarshal(res, &hCreateResp)
+		createdIDs := hCreateResp["hostids"]
+
+		i := 0
+		for _, hostParams := range toCreate {
+			hostName := hostParams["host"].(string)
+			hostIDByName[hostName] = createdIDs[i]
+			i++
+		}
+		log.Printf("[INFO] %d hosts criados", len(toCreate))
+	}
+
+	hostIDs := make([]string, len(hostNames))
+	for i, hostName := range hostNames {
+		hostIDs[i] = hostIDByName[hostName]
+	}
+	return hostIDs, nil
+}
+
+// ensureItemsConcurrently resolve e cria, em paralelo com até numWorkers
+// goroutines, os itens perf.test[1..numItems] de cada host, reportando o
+// progresso ao final de cada host processado.
+func ensureItemsConcurrently(client *zabbixapi.Client, hostNames, hostIDs []string, numItems, numWorkers int) error {
+	semaphore := make(chan struct{}, numWorkers)
+	var wg sync.WaitGroup
+	var done int64
+	total := int64(len(hostNames))
+	errs := make(chan error, len(hostNames))
+
+	for i, hostName := range hostNames {
+		hostName, hostID := hostName, hostIDs[i]
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := ensureItemsForHost(client, hostName, hostID, numItems); err != nil {
+				errs <- fmt.Errorf("host %s: %v", hostName, err)
+				return
+			}
+			logProgress(atomic.AddInt64(&done, 1), total)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		return err
+	}
+	return nil
+}
+
+// ensureItemsForHost busca os itens já existentes de hostID em uma única
+// chamada item.get, e cria em uma única chamada item.create todos os itens
+// perf.test[1..numItems] que ainda faltam.
+func ensureItemsForHost(client *zabbixapi.Client, hostName, hostID string, numItems int) error {
+	res, err := client.Call("item.get", map[string]interface{}{
+		"output":  []string{"key_"},
+		"hostids": hostID,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao buscar itens: %v", err)
+	}
+
+	var existingItems []map[string]interface{}
+	json.Unmarshal(res, &existingItems)
+
+	existingKeys := make(map[string]bool, len(existingItems))
+	for _, item := range existingItems {
+		existingKeys[item["key_"].(string)] = true
+	}
+
+	var toCreate []map[string]interface{}
+	for j := 1; j <= numItems; j++ {
+		itemKey := fmt.Sprintf("perf.test[%d]", j)
+		if existingKeys[itemKey] {
+			continue
+		}
+		toCreate = append(toCreate, map[string]interface{}{
+			"name":       fmt.Sprintf("PerfItem-%d", j),
+			"key_":       itemKey,
+			"hostid":     hostID,
+			"type":       2,   // Zabbix trapper
+			"value_type": 0,   // Numeric float
+			"delay":      "0", // Não há polling, aguarda dados
+		})
+	}
+
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	if _, err := client.Call("item.create", toCreate); err != nil {
+		return fmt.Errorf("erro ao criar %d itens para o host %s: %v", len(toCreate), hostName, err)
+	}
+	return nil
+}
+
+// logProgress reporta o andamento do provisionamento de itens a cada host concluído.
+func logProgress(done, total int64) {
+	pct := float64(done) / float64(total) * 100
+	log.Printf("[INFO] Progresso: %d/%d hosts (%.1f%%)", done, total, pct)
+}
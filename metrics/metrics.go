@@ -0,0 +1,76 @@
+// Package metrics expõe as métricas de observabilidade do próprio load test:
+// um endpoint Prometheus /metrics e um relatório resumo ao final da execução.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	// ValuesSent conta os valores enviados com sucesso, por host.
+	ValuesSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "zbx_loadtest_values_sent_total",
+		Help: "Total de valores enviados com sucesso, por host.",
+	}, []string{"host"})
+
+	// ValuesFailed conta os valores cuja tentativa de envio falhou, por host.
+	ValuesFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "zbx_loadtest_values_failed_total",
+		Help: "Total de valores que falharam ao enviar, por host.",
+	}, []string{"host"})
+
+	// SendLatency mede a latência de ida e volta de cada envio trapper.
+	SendLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "zbx_loadtest_send_latency_seconds",
+		Help:    "Latência de ida e volta de cada envio trapper.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// APILatency mede a latência de cada chamada à API do Zabbix, por método.
+	APILatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "zbx_loadtest_api_latency_seconds",
+		Help:    "Latência de cada chamada à API do Zabbix, por método.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// InFlightGoroutines reporta quantas goroutines de envio estão ativas no momento.
+	InFlightGoroutines = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "zbx_loadtest_inflight_goroutines",
+		Help: "Número de goroutines de envio ativas no momento.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ValuesSent, ValuesFailed, SendLatency, APILatency, InFlightGoroutines)
+}
+
+// StartServer sobe o endpoint /metrics em background na porta informada e
+// retorna o *http.Server para que o chamador possa encerrá-lo com Shutdown.
+func StartServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("[ERRO] servidor de métricas encerrou inesperadamente: %v", err)
+		}
+	}()
+	return srv
+}
+
+// Shutdown encerra o servidor de métricas, se houver um em execução.
+func Shutdown(srv *http.Server) {
+	if srv == nil {
+		return
+	}
+	if err := srv.Shutdown(context.Background()); err != nil {
+		log.Printf("[ERRO] erro ao encerrar servidor de métricas: %v", err)
+	}
+}
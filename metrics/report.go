@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// reservoirSize limita quantas amostras de latência o Recorder mantém em
+// memória simultaneamente. Acima desse número, novas amostras substituem
+// amostras antigas escolhidas aleatoriamente (reservoir sampling), para que
+// o uso de memória não cresça com a duração da execução.
+const reservoirSize = 10000
+
+// Recorder mantém uma amostra limitada (reservoir sample) das latências de
+// envio observadas, para permitir o cálculo de percentis (p50/p95/p99) ao
+// final da execução sem reter cada amostra individual, além de publicar
+// cada amostra no histograma Prometheus SendLatency.
+type Recorder struct {
+	mu        sync.Mutex
+	rng       *rand.Rand
+	reservoir []time.Duration
+	count     int64 // total de amostras observadas, incluindo as descartadas
+}
+
+// NewRecorder cria um Recorder vazio.
+func NewRecorder() *Recorder {
+	return &Recorder{rng: rand.New(rand.NewSource(int64(time.Now().UnixNano())))}
+}
+
+// Observe registra uma latência de envio, mantendo o reservoir dentro de
+// reservoirSize via amostragem aleatória (algoritmo R de Vitter).
+func (r *Recorder) Observe(d time.Duration) {
+	SendLatency.Observe(d.Seconds())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.count++
+	if len(r.reservoir) < reservoirSize {
+		r.reservoir = append(r.reservoir, d)
+		return
+	}
+	if j := r.rng.Int63n(r.count); j < int64(reservoirSize) {
+		r.reservoir[j] = d
+	}
+}
+
+// Percentile retorna a latência no percentil p (0-100) observada no
+// reservoir: ordena as amostras e retorna a de índice floor(p/100*(n-1)),
+// sem interpolação (arredonda a posição para baixo). Retorna 0 se nenhuma
+// amostra foi registrada.
+func (r *Recorder) Percentile(p float64) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.reservoir) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), r.reservoir...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(p / 100 * float64(len(sorted)-1))
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// Summary resume os resultados de uma execução de carga, para permitir
+// comparar runs entre ajustes de configuração do servidor Zabbix.
+type Summary struct {
+	StartedAt      time.Time     `json:"started_at"`
+	Duration       time.Duration `json:"duration_ns"`
+	TotalSent      int64         `json:"total_sent"`
+	TotalFailed    int64         `json:"total_failed"`
+	EffectiveNVPS  float64       `json:"effective_nvps"`
+	SendLatencyP50 time.Duration `json:"send_latency_p50_ns"`
+	SendLatencyP95 time.Duration `json:"send_latency_p95_ns"`
+	SendLatencyP99 time.Duration `json:"send_latency_p99_ns"`
+}
+
+// NewSummary monta um Summary a partir das amostras acumuladas em recorder e
+// dos contadores totais de uma execução de duration.
+func NewSummary(recorder *Recorder, startedAt time.Time, duration time.Duration, sent, failed int64) Summary {
+	summary := Summary{
+		StartedAt:      startedAt,
+		Duration:       duration,
+		TotalSent:      sent,
+		TotalFailed:    failed,
+		SendLatencyP50: recorder.Percentile(50),
+		SendLatencyP95: recorder.Percentile(95),
+		SendLatencyP99: recorder.Percentile(99),
+	}
+	if duration > 0 {
+		summary.EffectiveNVPS = float64(sent) / duration.Seconds()
+	}
+	return summary
+}
+
+// String formata o resumo como texto legível, usado no relatório em modo texto.
+func (s Summary) String() string {
+	return fmt.Sprintf(
+		"Início: %s\nDuração: %s\nEnviados: %d\nFalhos: %d\nNVPS efetivo: %.2f\nLatência p50: %s\nLatência p95: %s\nLatência p99: %s\n",
+		s.StartedAt.Format(time.RFC3339), s.Duration, s.TotalSent, s.TotalFailed, s.EffectiveNVPS,
+		s.SendLatencyP50, s.SendLatencyP95, s.SendLatencyP99,
+	)
+}
+
+// WriteJSON grava o resumo em formato JSON no caminho informado.
+func (s Summary) WriteJSON(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("erro ao serializar relatório: %v", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// WriteText grava o resumo em formato texto no caminho informado.
+func (s Summary) WriteText(path string) error {
+	return os.WriteFile(path, []byte(s.String()), 0644)
+}
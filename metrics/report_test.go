@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecorderPercentile(t *testing.T) {
+	r := NewRecorder()
+	for i := 1; i <= 100; i++ {
+		r.Observe(time.Duration(i) * time.Millisecond)
+	}
+
+	if p := r.Percentile(50); p != 50*time.Millisecond {
+		t.Fatalf("Percentile(50) = %s, esperado 50ms", p)
+	}
+	if p := r.Percentile(99); p != 99*time.Millisecond {
+		t.Fatalf("Percentile(99) = %s, esperado 99ms", p)
+	}
+}
+
+func TestRecorderPercentileSemAmostras(t *testing.T) {
+	r := NewRecorder()
+	if p := r.Percentile(50); p != 0 {
+		t.Fatalf("Percentile(50) sem amostras = %s, esperado 0", p)
+	}
+}
+
+func TestRecorderObserveLimitaReservoir(t *testing.T) {
+	r := NewRecorder()
+	for i := 0; i < reservoirSize+500; i++ {
+		r.Observe(time.Duration(i) * time.Millisecond)
+	}
+	if len(r.reservoir) != reservoirSize {
+		t.Fatalf("len(reservoir) = %d, esperado %d", len(r.reservoir), reservoirSize)
+	}
+	if r.count != int64(reservoirSize+500) {
+		t.Fatalf("count = %d, esperado %d", r.count, reservoirSize+500)
+	}
+}
+
+func TestNewSummary(t *testing.T) {
+	r := NewRecorder()
+	r.Observe(10 * time.Millisecond)
+	r.Observe(20 * time.Millisecond)
+
+	summary := NewSummary(r, time.Now(), time.Second, 2, 0)
+	if summary.TotalSent != 2 || summary.TotalFailed != 0 {
+		t.Fatalf("summary = %+v, contadores inesperados", summary)
+	}
+	if summary.EffectiveNVPS != 2 {
+		t.Fatalf("EffectiveNVPS = %v, esperado 2", summary.EffectiveNVPS)
+	}
+}
@@ -0,0 +1,258 @@
+// Package sender implementa o protocolo nativo do zabbix_sender (trapper),
+// permitindo enviar valores diretamente por TCP em vez de invocar o binário
+// zabbix_sender a cada envio.
+package sender
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// protocolSignature é o cabeçalho fixo usado pelo protocolo trapper do Zabbix.
+var protocolSignature = [4]byte{'Z', 'B', 'X', 'D'}
+
+const protocolFlag = 0x01
+
+const defaultTimeout = 5 * time.Second
+
+// Metric representa um valor a ser enviado ao Zabbix através do protocolo trapper.
+type Metric struct {
+	Host  string      `json:"host"`
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+	Clock int64       `json:"clock,omitempty"`
+}
+
+// Response resume a contagem de valores processados/falhados retornada pelo
+// servidor Zabbix ao final de um envio.
+type Response struct {
+	Processed int
+	Failed    int
+	Total     int
+	Seconds   float64
+}
+
+// PSKConfig descreve as credenciais de uma conexão TLS-PSK com o servidor Zabbix.
+//
+// A biblioteca padrão crypto/tls do Go não implementa os cipher suites
+// TLS-PSK usados pelo Zabbix (ex.: PSK-AES128-CBC-SHA), então PSKConfig é
+// consumido por um Dialer customizado; sem um, Send/SendBatch retornam erro
+// caso PSKConfig esteja definido.
+type PSKConfig struct {
+	Identity string
+	Key      string // chave PSK em hexadecimal, mesmo formato do arquivo TLSPSKFile
+}
+
+// TLSConfig agrupa as opções de conexão segura com o servidor Zabbix (trapper).
+type TLSConfig struct {
+	// CertFile/KeyFile/CAFile habilitam autenticação por certificado.
+	CertFile   string
+	KeyFile    string
+	CAFile     string
+	ServerName string
+
+	// PSK habilita autenticação por chave pré-compartilhada (ver PSKConfig).
+	PSK *PSKConfig
+
+	// Dialer, se definido, substitui a conexão TLS padrão (necessário para PSK).
+	Dialer func(network, addr string) (net.Conn, error)
+}
+
+// Config agrupa os parâmetros de conexão de um Sender.
+type Config struct {
+	// Address é o endereço host:port do servidor Zabbix, ex.: "127.0.0.1:10051".
+	Address string
+	// Timeout é o tempo máximo por envio. Padrão: 5s.
+	Timeout time.Duration
+	// TLS, se definido, habilita uma conexão segura com o servidor.
+	TLS *TLSConfig
+}
+
+// Sender envia valores para um servidor Zabbix usando o protocolo trapper
+// nativo. Cada envio abre sua própria conexão TCP/TLS: o servidor Zabbix
+// encerra a conexão assim que responde a uma requisição trapper, então as
+// conexões não podem ser reaproveitadas entre envios.
+type Sender struct {
+	addr    string
+	timeout time.Duration
+	tlsCfg  *TLSConfig
+}
+
+// New cria um Sender pronto para uso a partir de cfg.
+func New(cfg Config) *Sender {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Sender{
+		addr:    cfg.Address,
+		timeout: timeout,
+		tlsCfg:  cfg.TLS,
+	}
+}
+
+// Send envia um único valor para host/key com o clock informado.
+func (s *Sender) Send(host, key string, value interface{}, clock time.Time) error {
+	resp, err := s.SendBatch([]Metric{{Host: host, Key: key, Value: value, Clock: clock.Unix()}})
+	if err != nil {
+		return err
+	}
+	if resp.Failed > 0 {
+		return fmt.Errorf("sender: %d de %d valores falharam", resp.Failed, resp.Total)
+	}
+	return nil
+}
+
+// SendBatch envia um lote de métricas em uma única requisição trapper e
+// retorna o resumo informado pelo servidor.
+func (s *Sender) SendBatch(metrics []Metric) (*Response, error) {
+	payload, err := json.Marshal(struct {
+		Request string   `json:"request"`
+		Data    []Metric `json:"data"`
+		Clock   int64    `json:"clock"`
+	}{
+		Request: "sender data",
+		Data:    metrics,
+		Clock:   time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sender: erro ao serializar o payload: %v", err)
+	}
+
+	conn, err := s.dial()
+	if err != nil {
+		return nil, fmt.Errorf("sender: erro ao conectar em %s: %v", s.addr, err)
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(s.timeout))
+	if err := writeFrame(conn, payload); err != nil {
+		return nil, fmt.Errorf("sender: erro ao escrever requisição: %v", err)
+	}
+
+	respBody, err := readFrame(conn)
+	if err != nil {
+		return nil, fmt.Errorf("sender: erro ao ler resposta: %v", err)
+	}
+
+	var raw struct {
+		Response string `json:"response"`
+		Info     string `json:"info"`
+	}
+	if err := json.Unmarshal(respBody, &raw); err != nil {
+		return nil, fmt.Errorf("sender: erro ao decodificar resposta JSON: %v", err)
+	}
+
+	resp, err := parseTrapperInfo(raw.Info)
+	if err != nil {
+		return nil, fmt.Errorf("sender: erro ao interpretar resposta do servidor (%q): %v", raw.Info, err)
+	}
+	return resp, nil
+}
+
+// Close não mantém nenhum recurso em aberto (Sender não reaproveita
+// conexões entre envios); existe para manter a simetria com New e permitir
+// que chamadores a invoquem via defer sem precisar de um caso especial.
+func (s *Sender) Close() error {
+	return nil
+}
+
+// dial abre uma nova conexão com o servidor Zabbix, aplicando TLS quando configurado.
+func (s *Sender) dial() (net.Conn, error) {
+	if s.tlsCfg == nil {
+		return net.DialTimeout("tcp", s.addr, s.timeout)
+	}
+	if s.tlsCfg.Dialer != nil {
+		return s.tlsCfg.Dialer("tcp", s.addr)
+	}
+	if s.tlsCfg.PSK != nil {
+		return nil, fmt.Errorf("sender: TLS-PSK requer TLSConfig.Dialer (não suportado nativamente por crypto/tls)")
+	}
+
+	conf := &tls.Config{ServerName: s.tlsCfg.ServerName}
+	if s.tlsCfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.tlsCfg.CertFile, s.tlsCfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao carregar certificado: %v", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	if s.tlsCfg.CAFile != "" {
+		pool, err := loadCAPool(s.tlsCfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		conf.RootCAs = pool
+	}
+
+	dialer := &net.Dialer{Timeout: s.timeout}
+	return tls.DialWithDialer(dialer, "tcp", s.addr, conf)
+}
+
+// writeFrame escreve um payload no formato "ZBXD\x01" + tamanho (8 bytes,
+// little-endian) + corpo JSON, conforme o protocolo trapper do Zabbix.
+func writeFrame(w io.Writer, payload []byte) error {
+	var header bytes.Buffer
+	header.Write(protocolSignature[:])
+	header.WriteByte(protocolFlag)
+	binary.Write(&header, binary.LittleEndian, uint64(len(payload)))
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readFrame lê um frame no mesmo formato usado por writeFrame e retorna o
+// corpo JSON.
+func readFrame(r io.Reader) ([]byte, error) {
+	header := make([]byte, 13)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:4], protocolSignature[:]) {
+		return nil, fmt.Errorf("assinatura de protocolo inválida: %q", header[:4])
+	}
+	size := binary.LittleEndian.Uint64(header[5:13])
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// parseTrapperInfo interpreta a string "processed: X; failed: Y; total: Z;
+// seconds spent: S" retornada pelo servidor no campo "info".
+func parseTrapperInfo(info string) (*Response, error) {
+	resp := &Response{}
+	for _, part := range strings.Split(info, ";") {
+		kv := strings.SplitN(strings.TrimSpace(part), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(kv[0])
+		val := strings.TrimSpace(kv[1])
+		switch key {
+		case "processed":
+			resp.Processed, _ = strconv.Atoi(val)
+		case "failed":
+			resp.Failed, _ = strconv.Atoi(val)
+		case "total":
+			resp.Total, _ = strconv.Atoi(val)
+		case "seconds spent":
+			resp.Seconds, _ = strconv.ParseFloat(val, 64)
+		}
+	}
+	if resp.Total == 0 {
+		return nil, fmt.Errorf("resposta do servidor não contém contagem de valores")
+	}
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package sender
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteFrameReadFrameRoundTrip(t *testing.T) {
+	payload := []byte(`{"request":"sender data"}`)
+
+	var buf bytes.Buffer
+	if err := writeFrame(&buf, payload); err != nil {
+		t.Fatalf("writeFrame retornou erro: %v", err)
+	}
+
+	got, err := readFrame(&buf)
+	if err != nil {
+		t.Fatalf("readFrame retornou erro: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("payload lido = %q, esperado %q", got, payload)
+	}
+}
+
+func TestReadFrameInvalidSignature(t *testing.T) {
+	buf := bytes.NewBufferString("XXXX\x01\x00\x00\x00\x00\x00\x00\x00\x00")
+	if _, err := readFrame(buf); err == nil {
+		t.Fatal("esperava erro para assinatura de protocolo inválida, obteve nil")
+	}
+}
+
+func TestParseTrapperInfo(t *testing.T) {
+	info := "processed: 3; failed: 1; total: 4; seconds spent: 0.001234"
+	resp, err := parseTrapperInfo(info)
+	if err != nil {
+		t.Fatalf("parseTrapperInfo retornou erro: %v", err)
+	}
+	if resp.Processed != 3 || resp.Failed != 1 || resp.Total != 4 {
+		t.Fatalf("resp = %+v, esperado Processed=3 Failed=1 Total=4", resp)
+	}
+	if resp.Seconds != 0.001234 {
+		t.Fatalf("resp.Seconds = %v, esperado 0.001234", resp.Seconds)
+	}
+}
+
+func TestParseTrapperInfoSemTotal(t *testing.T) {
+	if _, err := parseTrapperInfo("processed: 0; failed: 0"); err == nil {
+		t.Fatal("esperava erro quando total está ausente/zero, obteve nil")
+	}
+}
@@ -0,0 +1,21 @@
+package sender
+
+import (
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// loadCAPool lê um arquivo PEM contendo uma ou mais CAs e monta o pool de
+// verificação usado para validar o certificado do servidor Zabbix.
+func loadCAPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler CA %s: %v", caFile, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("nenhum certificado válido encontrado em %s", caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,134 @@
+package workload
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"zabbix_loadtest/metrics"
+	"zabbix_loadtest/sender"
+)
+
+// Engine executa um Scenario, disparando valores para cada host na taxa alvo
+// configurada.
+type Engine struct {
+	Sender   *sender.Sender
+	Scenario *Scenario
+
+	totalSent   int64
+	totalFailed int64
+}
+
+// NewEngine cria um Engine pronto para executar scenario usando s para o
+// envio dos valores.
+func NewEngine(s *sender.Sender, scenario *Scenario) *Engine {
+	return &Engine{Sender: s, Scenario: scenario}
+}
+
+// Run executa o cenário até o fim de sua duração, bloqueando até que todos os
+// hosts tenham terminado, e retorna um resumo com as latências e a taxa
+// efetiva observadas.
+func (e *Engine) Run() (metrics.Summary, error) {
+	generators := make([]Generator, len(e.Scenario.Items))
+	for i, item := range e.Scenario.Items {
+		gen, err := newGenerator(item)
+		if err != nil {
+			return metrics.Summary{}, err
+		}
+		generators[i] = gen
+	}
+
+	duration := time.Duration(e.Scenario.DurationSec) * time.Second
+	rampUp := time.Duration(e.Scenario.RampUpSec) * time.Second
+	recorder := metrics.NewRecorder()
+	startedAt := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < e.Scenario.Hosts.Count; i++ {
+		hostName := fmt.Sprintf("%s-%d", e.Scenario.Hosts.Prefix, i+1)
+		wg.Add(1)
+		go e.runHost(hostName, generators, duration, rampUp, recorder, &wg)
+	}
+	wg.Wait()
+
+	summary := metrics.NewSummary(recorder, startedAt, time.Since(startedAt), atomic.LoadInt64(&e.totalSent), atomic.LoadInt64(&e.totalFailed))
+	return summary, nil
+}
+
+// runHost envia valores para um único host até que duration se esgote,
+// respeitando a taxa alvo (com rampa de subida opcional) via rateLimiter, e
+// publica latência/contadores em recorder e nas métricas Prometheus do pacote
+// metrics. O rateLimiter paceia cada valor individualmente emitido (e não
+// cada ciclo), então NVPSPerHost é a taxa total de valores por segundo
+// somando todos os itens do cenário.
+func (e *Engine) runHost(hostName string, generators []Generator, duration, rampUp time.Duration, recorder *metrics.Recorder, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	metrics.InFlightGoroutines.Inc()
+	defer metrics.InFlightGoroutines.Dec()
+
+	limiter := newRateLimiter(e.Scenario.Rate.NVPSPerHost, rampUp)
+	deadline := time.Now().Add(duration)
+	var cycles, valuesSent int64
+
+	for time.Now().Before(deadline) {
+		for i, item := range e.Scenario.Items {
+			limiter.wait(valuesSent)
+			now := time.Now()
+			value := generators[i].Next(now, cycles)
+
+			sendStart := time.Now()
+			err := e.Sender.Send(hostName, item.Key, value, now)
+			recorder.Observe(time.Since(sendStart))
+			valuesSent++
+
+			if err != nil {
+				log.Printf("[ERRO] Falha ao enviar valor para '%s' (%s): %v", hostName, item.Key, err)
+				metrics.ValuesFailed.WithLabelValues(hostName).Inc()
+				atomic.AddInt64(&e.totalFailed, 1)
+			} else {
+				metrics.ValuesSent.WithLabelValues(hostName).Inc()
+				atomic.AddInt64(&e.totalSent, 1)
+			}
+		}
+		cycles++
+	}
+	log.Printf("[INFO] Envio de dados para o host '%s' concluído. Total de ciclos enviados: %d.", hostName, cycles)
+}
+
+// rateLimiter controla o intervalo entre envios para atingir uma taxa alvo em
+// valores por segundo (NVPS), aumentando-a linearmente durante rampUp.
+type rateLimiter struct {
+	target float64
+	rampUp time.Duration
+	start  time.Time
+}
+
+func newRateLimiter(targetNVPS float64, rampUp time.Duration) *rateLimiter {
+	if targetNVPS <= 0 {
+		targetNVPS = 1
+	}
+	return &rateLimiter{target: targetNVPS, rampUp: rampUp, start: time.Now()}
+}
+
+// wait bloqueia até o instante esperado para o próximo envio (sent é o número
+// de envios já realizados), recalculando a taxa efetiva enquanto ainda está
+// dentro da janela de rampa de subida.
+func (r *rateLimiter) wait(sent int64) {
+	elapsed := time.Since(r.start)
+	rate := r.target
+	if r.rampUp > 0 && elapsed < r.rampUp {
+		rate = r.target * float64(elapsed) / float64(r.rampUp)
+		if rate < r.target/1000 {
+			rate = r.target / 1000
+		}
+	}
+
+	expected := time.Duration(float64(sent) / rate * float64(time.Second))
+	sleepFor := r.start.Add(expected).Sub(time.Now())
+	if sleepFor > 0 {
+		time.Sleep(sleepFor)
+	}
+}
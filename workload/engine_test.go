@@ -0,0 +1,42 @@
+package workload
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterPacesToTargetRate(t *testing.T) {
+	limiter := newRateLimiter(100, 0) // sem rampa, 100 NVPS -> 10ms por envio
+	start := time.Now()
+
+	for sent := int64(0); sent < 5; sent++ {
+		limiter.wait(sent)
+	}
+	elapsed := time.Since(start)
+
+	// 5 envios a 100/s deveriam levar ~40ms (sent=0 não espera); tolera folga.
+	if elapsed < 30*time.Millisecond {
+		t.Fatalf("rateLimiter.wait não pacificou os envios, decorrido %s", elapsed)
+	}
+}
+
+func TestRateLimiterRampUp(t *testing.T) {
+	limiter := newRateLimiter(1000, 50*time.Millisecond)
+	// No início da rampa a taxa efetiva é bem menor que o alvo, então aguardar
+	// o segundo envio deve bloquear por mais tempo que na taxa plena (1ms a
+	// 1000 NVPS).
+	start := time.Now()
+	limiter.wait(0)
+	limiter.wait(1)
+	elapsed := time.Since(start)
+	if elapsed <= time.Millisecond {
+		t.Fatalf("esperava algum atraso durante a rampa de subida, decorrido %s", elapsed)
+	}
+}
+
+func TestNewRateLimiterDefaultsNonPositiveTarget(t *testing.T) {
+	limiter := newRateLimiter(0, 0)
+	if limiter.target != 1 {
+		t.Fatalf("target = %v, esperado default 1 para alvo não positivo", limiter.target)
+	}
+}
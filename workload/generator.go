@@ -0,0 +1,171 @@
+package workload
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Generator produz o próximo valor de um item a cada envio. t é o instante do
+// envio e seq é o número sequencial do valor (começando em 0), útil para
+// geradores que reproduzem uma sequência fixa.
+type Generator interface {
+	Next(t time.Time, seq int64) float64
+}
+
+// ConstantGenerator sempre retorna o mesmo valor.
+type ConstantGenerator struct {
+	Value float64
+}
+
+// Next implementa Generator.
+func (g ConstantGenerator) Next(_ time.Time, _ int64) float64 { return g.Value }
+
+// CounterGenerator retorna um contador crescente, começando em 1. Reproduz o
+// comportamento do envio incremental original do load test.
+type CounterGenerator struct{}
+
+// Next implementa Generator.
+func (g CounterGenerator) Next(_ time.Time, seq int64) float64 { return float64(seq + 1) }
+
+// UniformGenerator sorteia um valor uniforme em [Min, Max).
+type UniformGenerator struct {
+	Min, Max float64
+	mu       sync.Mutex
+	rng      *rand.Rand
+}
+
+// NewUniformGenerator cria um UniformGenerator com sua própria fonte de
+// aleatoriedade. A mesma instância pode ser compartilhada por múltiplas
+// goroutines (um gerador por item é reaproveitado entre os hosts): o acesso
+// ao rng é protegido por mutex, já que *rand.Rand não é seguro para uso
+// concorrente.
+func NewUniformGenerator(min, max float64) *UniformGenerator {
+	return &UniformGenerator{Min: min, Max: max, rng: rand.New(rand.NewSource(int64(time.Now().UnixNano())))}
+}
+
+// Next implementa Generator.
+func (g *UniformGenerator) Next(_ time.Time, _ int64) float64 {
+	g.mu.Lock()
+	v := g.rng.Float64()
+	g.mu.Unlock()
+	return g.Min + v*(g.Max-g.Min)
+}
+
+// GaussianGenerator sorteia um valor seguindo uma distribuição normal.
+type GaussianGenerator struct {
+	Mean, StdDev float64
+	mu           sync.Mutex
+	rng          *rand.Rand
+}
+
+// NewGaussianGenerator cria um GaussianGenerator com sua própria fonte de
+// aleatoriedade. A mesma instância pode ser compartilhada por múltiplas
+// goroutines (um gerador por item é reaproveitado entre os hosts): o acesso
+// ao rng é protegido por mutex, já que *rand.Rand não é seguro para uso
+// concorrente.
+func NewGaussianGenerator(mean, stdDev float64) *GaussianGenerator {
+	return &GaussianGenerator{Mean: mean, StdDev: stdDev, rng: rand.New(rand.NewSource(int64(time.Now().UnixNano())))}
+}
+
+// Next implementa Generator.
+func (g *GaussianGenerator) Next(_ time.Time, _ int64) float64 {
+	g.mu.Lock()
+	v := g.rng.NormFloat64()
+	g.mu.Unlock()
+	return g.Mean + v*g.StdDev
+}
+
+// SineGenerator produz uma onda senoidal em função do tempo.
+type SineGenerator struct {
+	Amplitude float64
+	Offset    float64
+	PeriodSec float64
+}
+
+// Next implementa Generator.
+func (g SineGenerator) Next(t time.Time, _ int64) float64 {
+	phase := 2 * math.Pi * float64(t.UnixNano()) / float64(time.Second) / g.PeriodSec
+	return g.Offset + g.Amplitude*math.Sin(phase)
+}
+
+// SawtoothGenerator produz uma onda dente-de-serra em função do tempo.
+type SawtoothGenerator struct {
+	Amplitude float64
+	PeriodSec float64
+}
+
+// Next implementa Generator.
+func (g SawtoothGenerator) Next(t time.Time, _ int64) float64 {
+	elapsed := math.Mod(float64(t.UnixNano())/float64(time.Second), g.PeriodSec)
+	return g.Amplitude * (elapsed / g.PeriodSec)
+}
+
+// ReplayGenerator reproduz uma sequência de valores lida de um CSV,
+// repetindo-a ciclicamente quando se esgota.
+type ReplayGenerator struct {
+	Values []float64
+}
+
+// Next implementa Generator.
+func (g *ReplayGenerator) Next(_ time.Time, seq int64) float64 {
+	if len(g.Values) == 0 {
+		return 0
+	}
+	return g.Values[seq%int64(len(g.Values))]
+}
+
+// newGenerator constrói um Generator a partir da configuração declarada no
+// arquivo de cenário.
+func newGenerator(cfg ItemConfig) (Generator, error) {
+	params := cfg.Params
+	switch cfg.Generator {
+	case "", "constant":
+		return ConstantGenerator{Value: paramFloat(params, "value", 0)}, nil
+	case "counter":
+		return CounterGenerator{}, nil
+	case "uniform":
+		return NewUniformGenerator(paramFloat(params, "min", 0), paramFloat(params, "max", 100)), nil
+	case "gaussian":
+		return NewGaussianGenerator(paramFloat(params, "mean", 0), paramFloat(params, "stddev", 1)), nil
+	case "sine":
+		return SineGenerator{
+			Amplitude: paramFloat(params, "amplitude", 1),
+			Offset:    paramFloat(params, "offset", 0),
+			PeriodSec: paramFloat(params, "period_sec", 60),
+		}, nil
+	case "sawtooth":
+		return SawtoothGenerator{
+			Amplitude: paramFloat(params, "amplitude", 1),
+			PeriodSec: paramFloat(params, "period_sec", 60),
+		}, nil
+	case "replay":
+		path, _ := params["csv_path"].(string)
+		values, err := loadReplayCSV(path)
+		if err != nil {
+			return nil, fmt.Errorf("gerador replay do item %s: %v", cfg.Key, err)
+		}
+		return &ReplayGenerator{Values: values}, nil
+	default:
+		return nil, fmt.Errorf("gerador desconhecido %q para o item %s", cfg.Generator, cfg.Key)
+	}
+}
+
+// paramFloat lê um parâmetro numérico opcional do mapa de parâmetros do item,
+// retornando def quando ausente ou de tipo inesperado.
+func paramFloat(params map[string]interface{}, key string, def float64) float64 {
+	v, ok := params[key]
+	if !ok {
+		return def
+	}
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	default:
+		return def
+	}
+}
@@ -0,0 +1,78 @@
+package workload
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConstantGenerator(t *testing.T) {
+	g := ConstantGenerator{Value: 42}
+	if v := g.Next(time.Now(), 0); v != 42 {
+		t.Fatalf("Next() = %v, esperado 42", v)
+	}
+}
+
+func TestCounterGenerator(t *testing.T) {
+	g := CounterGenerator{}
+	for seq := int64(0); seq < 3; seq++ {
+		if v := g.Next(time.Now(), seq); v != float64(seq+1) {
+			t.Fatalf("Next(_, %d) = %v, esperado %v", seq, v, seq+1)
+		}
+	}
+}
+
+func TestUniformGeneratorRange(t *testing.T) {
+	g := NewUniformGenerator(10, 20)
+	for i := 0; i < 1000; i++ {
+		v := g.Next(time.Now(), 0)
+		if v < 10 || v >= 20 {
+			t.Fatalf("Next() = %v, esperado em [10, 20)", v)
+		}
+	}
+}
+
+func TestUniformGeneratorConcurrentUse(t *testing.T) {
+	g := NewUniformGenerator(0, 1)
+	done := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		go func() {
+			for j := 0; j < 1000; j++ {
+				g.Next(time.Now(), 0)
+			}
+			done <- struct{}{}
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+}
+
+func TestReplayGeneratorCycles(t *testing.T) {
+	g := &ReplayGenerator{Values: []float64{1, 2, 3}}
+	want := []float64{1, 2, 3, 1, 2}
+	for seq, w := range want {
+		if v := g.Next(time.Now(), int64(seq)); v != w {
+			t.Fatalf("Next(_, %d) = %v, esperado %v", seq, v, w)
+		}
+	}
+}
+
+func TestReplayGeneratorEmpty(t *testing.T) {
+	g := &ReplayGenerator{}
+	if v := g.Next(time.Now(), 0); v != 0 {
+		t.Fatalf("Next() = %v, esperado 0 para valores vazios", v)
+	}
+}
+
+func TestParamFloat(t *testing.T) {
+	params := map[string]interface{}{"a": 1.5, "b": 2}
+	if v := paramFloat(params, "a", 0); v != 1.5 {
+		t.Fatalf("paramFloat(a) = %v, esperado 1.5", v)
+	}
+	if v := paramFloat(params, "b", 0); v != 2 {
+		t.Fatalf("paramFloat(b) = %v, esperado 2", v)
+	}
+	if v := paramFloat(params, "ausente", 9); v != 9 {
+		t.Fatalf("paramFloat(ausente) = %v, esperado default 9", v)
+	}
+}
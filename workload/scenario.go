@@ -0,0 +1,96 @@
+package workload
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HostsConfig descreve os hosts alvo de um cenário de carga.
+type HostsConfig struct {
+	Prefix string `json:"prefix" yaml:"prefix"`
+	Count  int    `json:"count" yaml:"count"`
+}
+
+// ItemConfig descreve um item e o gerador de valores usado para ele.
+type ItemConfig struct {
+	Key       string                 `json:"key" yaml:"key"`
+	Generator string                 `json:"generator" yaml:"generator"`
+	Params    map[string]interface{} `json:"params" yaml:"params"`
+}
+
+// RateConfig descreve a taxa de envio alvo.
+type RateConfig struct {
+	NVPSPerHost float64 `json:"nvps_per_host" yaml:"nvps_per_host"`
+}
+
+// Scenario descreve um cenário de carga completo: hosts, itens, taxa,
+// duração e rampa de subida.
+type Scenario struct {
+	Hosts       HostsConfig  `json:"hosts" yaml:"hosts"`
+	Items       []ItemConfig `json:"items" yaml:"items"`
+	Rate        RateConfig   `json:"rate" yaml:"rate"`
+	DurationSec int          `json:"duration_sec" yaml:"duration_sec"`
+	RampUpSec   int          `json:"ramp_up_sec" yaml:"ramp_up_sec"`
+}
+
+// LoadScenario lê um cenário de um arquivo YAML ou JSON, escolhendo o
+// decodificador pela extensão do arquivo.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler cenário %s: %v", path, err)
+	}
+
+	var scenario Scenario
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &scenario); err != nil {
+			return nil, fmt.Errorf("erro ao decodificar cenário YAML %s: %v", path, err)
+		}
+	case ".json":
+		if err := json.Unmarshal(data, &scenario); err != nil {
+			return nil, fmt.Errorf("erro ao decodificar cenário JSON %s: %v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("extensão de cenário não suportada: %s (use .yaml, .yml ou .json)", ext)
+	}
+	return &scenario, nil
+}
+
+// loadReplayCSV lê uma única coluna numérica de um CSV para alimentar um
+// ReplayGenerator.
+func loadReplayCSV(path string) ([]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, 0, len(records))
+	for _, row := range records {
+		if len(row) == 0 {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(row[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("linha inválida %q: %v", row[0], err)
+		}
+		values = append(values, v)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("CSV %s não contém valores", path)
+	}
+	return values, nil
+}
@@ -0,0 +1,179 @@
+// Package zabbixapi implementa um cliente mínimo para a API JSON-RPC do
+// Zabbix, compartilhado pelos subcomandos cmd/setup e cmd/cleanup.
+package zabbixapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"zabbix_loadtest/metrics"
+)
+
+const (
+	// MaxAttempts é o número máximo de tentativas por chamada (a primeira
+	// tentativa conta como uma).
+	MaxAttempts  = 5
+	callTimeout  = 30 * time.Second
+	retryBase    = 200 * time.Millisecond
+	retryMaxWait = 5 * time.Second
+)
+
+// Request define a estrutura de uma solicitação padrão para a API do Zabbix.
+type Request struct {
+	Jsonrpc string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+	ID      int         `json:"id"`
+}
+
+// Response define a estrutura de uma resposta da API do Zabbix.
+type Response struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+	Error   *APIError       `json:"error,omitempty"`
+}
+
+// APIError define a estrutura de erro retornada pela API.
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
+
+// retriableErrorCodes lista os códigos de erro JSON-RPC da API do Zabbix
+// considerados transitórios (em oposição a erros permanentes como
+// parâmetros inválidos ou falha de autenticação).
+var retriableErrorCodes = map[int]bool{
+	-32603: true, // Internal error
+}
+
+// sessionTerminatedMessage é a mensagem retornada pela API do Zabbix quando o
+// token de autenticação expira ou é invalidado em outro lugar; é transitória
+// do ponto de vista do load test (uma nova tentativa pode ter sucesso caso o
+// token seja renovado externamente), então também é tratada como retriável.
+const sessionTerminatedMessage = "Session terminated, re-login, please"
+
+// Client concentra a URL e o token usados em chamadas repetidas à API.
+type Client struct {
+	URL   string
+	Token string
+}
+
+// New cria um Client pronto para uso.
+func New(url, token string) *Client {
+	return &Client{URL: url, Token: token}
+}
+
+// Call envia uma solicitação ao método informado, tentando novamente em caso
+// de falhas transitórias (erros de rede, HTTP 502/503/504, erro interno da
+// API) com backoff exponencial e jitter.
+func (c *Client) Call(method string, params interface{}) (json.RawMessage, error) {
+	var lastErr error
+	for attempt := 1; attempt <= MaxAttempts; attempt++ {
+		result, retriable, err := c.callOnce(method, params)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+		if !retriable || attempt == MaxAttempts {
+			return nil, lastErr
+		}
+
+		delay := backoffWithJitter(attempt)
+		log.Printf("[AVISO] Chamada '%s' falhou (tentativa %d/%d), tentando novamente em %s: %v", method, attempt, MaxAttempts, delay, err)
+		time.Sleep(delay)
+	}
+	return nil, lastErr
+}
+
+// callOnce executa uma única tentativa de chamada à API e indica se o erro
+// retornado (quando houver) é transitório e vale a pena repetir.
+func (c *Client) callOnce(method string, params interface{}) (json.RawMessage, bool, error) {
+	start := time.Now()
+	defer func() {
+		metrics.APILatency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	}()
+
+	reqBody := Request{
+		Jsonrpc: "2.0",
+		Method:  method,
+		Params:  params,
+		ID:      1,
+	}
+
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, false, fmt.Errorf("erro ao serializar o corpo da requisição: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, "POST", c.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, false, fmt.Errorf("erro ao criar a requisição HTTP: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+c.Token)
+
+	client := &http.Client{Timeout: callTimeout}
+	resp, err := client.Do(request)
+	if err != nil {
+		return nil, true, fmt.Errorf("erro ao executar a requisição: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("erro ao ler o corpo da resposta: %v", err)
+	}
+
+	if isRetriableHTTPStatus(resp.StatusCode) {
+		return nil, true, fmt.Errorf("erro HTTP %d do servidor Zabbix", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("erro HTTP %d do servidor Zabbix", resp.StatusCode)
+	}
+
+	var zResp Response
+	if err := json.Unmarshal(respBody, &zResp); err != nil {
+		return nil, false, fmt.Errorf("erro ao decodificar a resposta JSON: %v", err)
+	}
+
+	if zResp.Error != nil {
+		retriable := retriableErrorCodes[zResp.Error.Code] || zResp.Error.Message == sessionTerminatedMessage
+		return nil, retriable, fmt.Errorf("erro da API: %s - %s", zResp.Error.Message, zResp.Error.Data)
+	}
+
+	return zResp.Result, false, nil
+}
+
+// isRetriableHTTPStatus reporta se status é um código HTTP tipicamente
+// transitório (gateway/proxy sobrecarregado ou servidor reiniciando).
+func isRetriableHTTPStatus(status int) bool {
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoffWithJitter calcula o atraso antes da tentativa seguinte (attempt é
+// 1-based), dobrando a cada tentativa até retryMaxWait e aplicando jitter
+// para evitar que chamadas concorrentes retentem em sincronia.
+func backoffWithJitter(attempt int) time.Duration {
+	delay := retryBase * time.Duration(1<<uint(attempt-1))
+	if delay > retryMaxWait {
+		delay = retryMaxWait
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)))
+	return delay/2 + jitter/2
+}
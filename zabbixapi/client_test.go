@@ -0,0 +1,57 @@
+package zabbixapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoffWithJitterCresceEDobraATELimite(t *testing.T) {
+	prev := time.Duration(0)
+	for attempt := 1; attempt <= MaxAttempts; attempt++ {
+		delay := backoffWithJitter(attempt)
+		if delay < 0 {
+			t.Fatalf("backoffWithJitter(%d) = %s, não deveria ser negativo", attempt, delay)
+		}
+		if delay > retryMaxWait {
+			t.Fatalf("backoffWithJitter(%d) = %s, acima do teto retryMaxWait=%s", attempt, delay, retryMaxWait)
+		}
+		if delay <= prev {
+			t.Fatalf("backoffWithJitter(%d) = %s, esperado maior que a tentativa anterior (%s)", attempt, delay, prev)
+		}
+		prev = delay
+	}
+}
+
+func TestIsRetriableHTTPStatus(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusBadGateway:          true,
+		http.StatusServiceUnavailable:  true,
+		http.StatusGatewayTimeout:      true,
+		http.StatusOK:                  false,
+		http.StatusBadRequest:          false,
+		http.StatusInternalServerError: false,
+	}
+	for status, want := range cases {
+		if got := isRetriableHTTPStatus(status); got != want {
+			t.Errorf("isRetriableHTTPStatus(%d) = %v, esperado %v", status, got, want)
+		}
+	}
+}
+
+func TestRetriableErrorCodes(t *testing.T) {
+	if !retriableErrorCodes[-32603] {
+		t.Fatal("-32603 (Internal error) deveria ser retriável")
+	}
+	if retriableErrorCodes[-32602] {
+		t.Fatal("-32602 não deveria ser retriável por padrão")
+	}
+}
+
+func TestSessionTerminatedEhRetriavel(t *testing.T) {
+	err := &APIError{Code: -32602, Message: sessionTerminatedMessage}
+	retriable := retriableErrorCodes[err.Code] || err.Message == sessionTerminatedMessage
+	if !retriable {
+		t.Fatal("erro de sessão encerrada deveria ser classificado como retriável")
+	}
+}